@@ -1,9 +1,12 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -14,6 +17,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	clientset "k8s.io/client-go/kubernetes"
@@ -24,8 +28,10 @@ import (
 
 	"github.com/longhorn/backupstore"
 
+	"github.com/longhorn/longhorn-manager/datamover"
 	"github.com/longhorn/longhorn-manager/datastore"
 	"github.com/longhorn/longhorn-manager/engineapi"
+	"github.com/longhorn/longhorn-manager/metrics"
 	"github.com/longhorn/longhorn-manager/types"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta1"
@@ -33,6 +39,16 @@ import (
 
 const (
 	BackupStatusQueryInterval = 2 * time.Second
+
+	// BackupProgressReportInterval rate-limits how often backupCreation
+	// writes intermediate Status.Progress/ReplicaProgress back to the
+	// Backup CR, so a fast-polling backup doesn't churn the API server.
+	BackupProgressReportInterval = 10 * time.Second
+
+	// DataMoverJobCachePath is where the data mover Manager persists its
+	// in-flight job records so a controller restart can reconcile jobs
+	// that are still running on this node instead of losing track of them.
+	DataMoverJobCachePath = "/var/lib/longhorn/datamover-jobs.json"
 )
 
 type BackupController struct {
@@ -48,9 +64,79 @@ type BackupController struct {
 
 	ds *datastore.DataStore
 
+	// dataMoverManager tracks in-flight DataMover jobs by Backup CR UID so
+	// a restart of this controller can rediscover and keep polling jobs
+	// still running on this node.
+	dataMoverManager *datamover.Manager
+
+	// inflight holds the live DataMover handle and cancel func for every
+	// backupCreation goroutine currently running on this controller,
+	// keyed by Backup CR UID, so reconcile can act on Spec.Canceled.
+	// Unlike dataMoverManager it does not survive a controller restart.
+	inflightMu sync.Mutex
+	inflight   map[k8stypes.UID]*inflightBackup
+
 	cacheSyncs []cache.InformerSynced
 }
 
+// inflightBackup is the state reconcile needs to cancel a running
+// backupCreation goroutine.
+type inflightBackup struct {
+	cancel context.CancelFunc
+	mover  datamover.DataMover
+	jobID  datamover.JobID
+}
+
+func (bc *BackupController) setInflight(uid k8stypes.UID, ib *inflightBackup) {
+	bc.inflightMu.Lock()
+	defer bc.inflightMu.Unlock()
+	bc.inflight[uid] = ib
+}
+
+func (bc *BackupController) updateInflightJob(uid k8stypes.UID, mover datamover.DataMover, jobID datamover.JobID) {
+	bc.inflightMu.Lock()
+	defer bc.inflightMu.Unlock()
+	if ib, ok := bc.inflight[uid]; ok {
+		ib.mover = mover
+		ib.jobID = jobID
+	}
+}
+
+func (bc *BackupController) clearInflight(uid k8stypes.UID) {
+	bc.inflightMu.Lock()
+	defer bc.inflightMu.Unlock()
+	delete(bc.inflight, uid)
+}
+
+// isInflight reports whether a backupCreation/resumeBackupPolling goroutine
+// is already polling uid's transfer on this controller.
+func (bc *BackupController) isInflight(uid k8stypes.UID) bool {
+	bc.inflightMu.Lock()
+	defer bc.inflightMu.Unlock()
+	_, ok := bc.inflight[uid]
+	return ok
+}
+
+// cancelInflight requests cancellation of the backup tracked under uid. It
+// returns false if no such backup is currently running on this controller
+// (e.g. it already finished, or ownership moved to another node).
+func (bc *BackupController) cancelInflight(uid k8stypes.UID) (canceled bool) {
+	bc.inflightMu.Lock()
+	ib, ok := bc.inflight[uid]
+	bc.inflightMu.Unlock()
+	if !ok {
+		return false
+	}
+
+	if ib.mover != nil && ib.jobID != "" {
+		if err := ib.mover.Cancel(ib.jobID); err != nil {
+			bc.logger.WithError(err).Warnf("Error canceling data mover job for backup %v", uid)
+		}
+	}
+	ib.cancel()
+	return true
+}
+
 func NewBackupController(
 	logger logrus.FieldLogger,
 	ds *datastore.DataStore,
@@ -65,6 +151,11 @@ func NewBackupController(
 		Interface: v1core.New(kubeClient.CoreV1().RESTClient()).Events(""),
 	})
 
+	dataMoverManager, err := datamover.NewManager(DataMoverJobCachePath)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to initialize data mover job manager from %v", DataMoverJobCachePath)
+	}
+
 	bc := &BackupController{
 		baseController: newBaseController("longhorn-backup", logger),
 
@@ -73,6 +164,9 @@ func NewBackupController(
 
 		ds: ds,
 
+		dataMoverManager: dataMoverManager,
+		inflight:         map[k8stypes.UID]*inflightBackup{},
+
 		kubeClient:    kubeClient,
 		eventRecorder: eventBroadcaster.NewRecorder(scheme, v1.EventSource{Component: "longhorn-backup-controller"}),
 	}
@@ -205,17 +299,23 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 
 	log := getLoggerForBackup(bc.logger, backup)
 
-	// Get default backup target
-	backupTarget, err := bc.ds.GetBackupTargetRO(types.DefaultBackupTargetName)
+	// Get the backup target, preferring the one named in Spec.BackupTargetName
+	// over the hard-coded default so a Backup CR can opt into a non-default
+	// target.
+	backupTargetName := backup.Spec.BackupTargetName
+	if backupTargetName == "" {
+		backupTargetName = types.DefaultBackupTargetName
+	}
+	backupTarget, err := bc.ds.GetBackupTargetRO(backupTargetName)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return err
 		}
-		log.Warnf("Cannot found the %s backup target", types.DefaultBackupTargetName)
+		log.Warnf("Cannot found the %s backup target", backupTargetName)
 		return nil
 	}
 
-	// Find the backup volume name from label
+	// Find the backup volume name, preferring Spec.VolumeName
 	backupVolumeName, err := bc.getBackupVolumeName(backup)
 	if err != nil {
 		if types.ErrorIsNotFound(err) {
@@ -276,6 +376,22 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 		}
 	}()
 
+	// Request cancellation of a running backup. The actual state
+	// transition to BackupStateCanceled is made by the backupCreation
+	// goroutine once it observes the cancellation, not here. If nothing is
+	// tracked on this controller (e.g. a restart raced with the cancel
+	// request, or ownership just moved here), fall through instead of
+	// returning: resumeBackupPolling needs to run first so there is
+	// something to cancel, or this Backup CR would be stuck InProgress
+	// with Spec.Canceled=true forever, since every future reconcile would
+	// keep matching this branch and never reach resumeBackupPolling below.
+	if backup.Spec.Canceled && backup.Status.State == longhorn.BackupStateInProgress && bc.isInflight(backup.UID) {
+		if bc.cancelInflight(backup.UID) {
+			log.Info("Requested cancellation of in-progress backup")
+		}
+		return nil
+	}
+
 	// Perform backup snapshot to remote backup target
 	if backup.Spec.SnapshotName != "" && backup.Status.State == "" {
 		// Initialize a backup target client
@@ -302,6 +418,17 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 		return nil
 	}
 
+	// Resume progress polling for a backup transfer that was already started
+	// by a (possibly earlier) controller process. bc.inflight does not
+	// survive a controller restart, so a Backup CR that is still InProgress
+	// with nothing polling it in this process is either newly picked up
+	// after a restart, or ownership just moved here; either way
+	// dataMoverManager's persisted job cache is what lets us pick the
+	// transfer back up instead of leaving it stuck InProgress forever.
+	if backup.Spec.SnapshotName != "" && backup.Status.State == longhorn.BackupStateInProgress && !bc.isInflight(backup.UID) {
+		return bc.resumeBackupPolling(log, backupVolumeName, backup)
+	}
+
 	// The backup config had synced
 	if !backup.Status.LastSyncedAt.IsZero() &&
 		!backup.Spec.SyncRequestedAt.After(backup.Status.LastSyncedAt.Time) {
@@ -341,6 +468,11 @@ func (bc *BackupController) reconcile(backupName string) (err error) {
 	backup.Status.VolumeCreated = backupInfo.VolumeCreated
 	backup.Status.VolumeBackingImageName = backupInfo.VolumeBackingImageName
 	backup.Status.LastSyncedAt = syncTime
+
+	if size, err := strconv.ParseFloat(backupInfo.Size, 64); err == nil {
+		metrics.BackupSizeBytes.WithLabelValues(backupInfo.VolumeName).Set(size)
+	}
+
 	return nil
 }
 
@@ -377,14 +509,107 @@ func (bc *BackupController) isResponsibleFor(b *longhorn.Backup, defaultEngineIm
 	return isPreferredOwner || continueToBeOwner || requiresNewOwner, nil
 }
 
+// appendBackupStatusMessage appends msg to messages, keeping at most
+// longhorn.MaxBackupStatusMessages of the most recent entries.
+func appendBackupStatusMessage(messages []string, msg string) []string {
+	messages = append(messages, msg)
+	if len(messages) > longhorn.MaxBackupStatusMessages {
+		messages = messages[len(messages)-longhorn.MaxBackupStatusMessages:]
+	}
+	return messages
+}
+
+// replicaBackupStatus is the subset of one engine replica's reported backup
+// status that aggregateReplicaBackupStatus needs, extracted from
+// longhorn.Engine so the aggregation rules can be unit tested without
+// constructing engine/volume CRDs.
+type replicaBackupStatus struct {
+	ReplicaName string
+	Progress    int
+	Error       string
+}
+
+// aggregateReplicaBackupStatus summarizes every replica's reported status
+// for one snapshot backup: how many replicas are involved, how many have
+// completed or failed, the summed progress (for averaging), a per-replica
+// progress map, and a bounded list of human-readable failure messages.
+func aggregateReplicaBackupStatus(statuses []replicaBackupStatus) (total, completed, failed, progressSum int, replicaProgress map[string]int, messages []string) {
+	replicaProgress = map[string]int{}
+	for _, s := range statuses {
+		total++
+		replicaProgress[s.ReplicaName] = s.Progress
+		progressSum += s.Progress
+		if s.Error != "" {
+			failed++
+			messages = appendBackupStatusMessage(messages, fmt.Sprintf("%v: %v", s.ReplicaName, s.Error))
+			continue
+		}
+		if s.Progress == 100 {
+			completed++
+		}
+	}
+	return
+}
+
+// getBackupVolumeName returns the name of the backup volume backup belongs
+// to. Spec.VolumeName is preferred; the types.LonghornLabelBackupVolume
+// label is only consulted as a fallback for Backup CRs created before
+// Spec.VolumeName existed, and its use is flagged with a deprecation event
+// since the label is invisible in the CRD schema and cannot be validated.
 func (bc *BackupController) getBackupVolumeName(backup *longhorn.Backup) (string, error) {
+	if backup.Spec.VolumeName != "" {
+		return backup.Spec.VolumeName, nil
+	}
+
 	backupVolumeName, ok := backup.Labels[types.LonghornLabelBackupVolume]
 	if !ok {
-		return "", fmt.Errorf("cannot find the backup volume label")
+		return "", fmt.Errorf("cannot find the backup volume name: Spec.VolumeName is empty and the backup volume label is not set")
 	}
+
+	bc.eventRecorder.Eventf(backup, corev1.EventTypeWarning, "DeprecatedBackupVolumeLabel",
+		"Backup %v relies on the deprecated %v label to identify its backup volume; set Spec.VolumeName instead", backup.Name, types.LonghornLabelBackupVolume)
+
 	return backupVolumeName, nil
 }
 
+// finalizeBackupState records the terminal outcome of a backup transfer: it
+// emits the longhorn_backup_state/longhorn_backup_duration_seconds metrics
+// and writes the resulting State/Warnings/Errors/ReplicaMessages/Progress
+// back to the Backup CR. Used by both backupCreation and
+// resumeBackupPolling so a controller restart mid-backup reports exactly
+// the same terminal status a backup that ran start-to-finish would have.
+func (bc *BackupController) finalizeBackupState(log logrus.FieldLogger, backupName, volumeName string, startTime time.Time, state longhorn.BackupState, warnings, errs int, replicaMessages []string) {
+	metrics.BackupState.WithLabelValues(string(state)).Inc()
+	metrics.BackupDurationSeconds.WithLabelValues(volumeName, string(state)).Observe(time.Since(startTime).Seconds())
+
+	backup, err := bc.ds.GetBackup(backupName)
+	if err != nil {
+		log.WithError(err).Errorf("Error get backup")
+		return
+	}
+	existingBackup := backup.DeepCopy()
+
+	backup.Status.State = state
+	backup.Status.Warnings = warnings
+	backup.Status.Errors = errs
+	backup.Status.ReplicaMessages = replicaMessages
+	switch state {
+	case longhorn.BackupStateCompleted, longhorn.BackupStatePartiallyFailed:
+		// Both states mean the transfer itself finished, so progress is
+		// complete even though PartiallyFailed means some replicas failed
+		// along the way. BackupStateError/BackupStateCanceled backups never
+		// finished and keep whatever progress they last reported, which is
+		// the more honest terminal value for those.
+		backup.Status.Progress = 100
+	}
+	if reflect.DeepEqual(existingBackup.Status, backup.Status) {
+		return
+	}
+	if _, err := bc.ds.UpdateBackupStatus(backup); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
+		log.WithError(err).Errorf("Error updating backup status")
+	}
+}
+
 func (bc *BackupController) backupCreation(log logrus.FieldLogger, engineClient engineapi.EngineClient, url string, credential map[string]string, backup *longhorn.Backup) error {
 	volumeName := engineClient.Name()
 
@@ -432,99 +657,345 @@ func (bc *BackupController) backupCreation(log logrus.FieldLogger, engineClient
 		biChecksum = bi.Status.Checksum
 	}
 
+	mover, err := datamover.NewForType(backup.Spec.DataMover, datamover.NewEngineMover(engineClient))
+	if err != nil {
+		return err
+	}
+
 	backup.Status.State = longhorn.BackupStateInProgress
 	event(nil, backup.Status.State, backup, volume)
 
+	snapshot := &datamover.Snapshot{
+		VolumeName:           volumeName,
+		SnapshotName:         backup.Spec.SnapshotName,
+		BackupName:           backup.Name,
+		Labels:               backup.Spec.Labels,
+		BackingImageName:     biName,
+		BackingImageChecksum: biChecksum,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bc.setInflight(backup.UID, &inflightBackup{cancel: cancel, mover: mover})
+
+	startTime := time.Now()
+
 	go func() {
 		state := backup.Status.State
+		var warnings, errs int
+		var replicaMessages []string
 		defer func() {
-			backup, err := bc.ds.GetBackup(backup.Name)
-			if err != nil {
-				log.WithError(err).Errorf("Error get backup")
-				return
-			}
-			existingBackup := backup.DeepCopy()
-
-			backup.Status.State = state
-			if reflect.DeepEqual(existingBackup.Status, backup.Status) {
-				return
-			}
-			if _, err := bc.ds.UpdateBackupStatus(backup); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
-				log.WithError(err).Errorf("Error updating backup status")
-				return
+			cancel()
+			bc.clearInflight(backup.UID)
+			if err := bc.dataMoverManager.Forget(backup.UID); err != nil {
+				log.WithError(err).Warn("Failed to forget data mover job")
 			}
+			bc.finalizeBackupState(log, backup.Name, volumeName, startTime, state, warnings, errs, replicaMessages)
 		}()
 
-		if _, err = engineClient.SnapshotBackup(backup.Name, backup.Spec.SnapshotName, url, biName, biChecksum, backup.Spec.Labels, credential); err != nil {
+		jobID, err := mover.StartBackup(ctx, snapshot, url, credential)
+		if err != nil {
 			state = longhorn.BackupStateError
 			event(err, state, backup, volume)
 			return
 		}
+		bc.updateInflightJob(backup.UID, mover, jobID)
+		if err := bc.dataMoverManager.Track(backup.UID, backup.Spec.DataMover, jobID); err != nil {
+			log.WithError(err).Warn("Failed to track data mover job")
+		}
 
-		// Monitor snapshot backup progress
-		for {
-			engines, err := bc.ds.ListVolumeEngines(volume.Name)
-			if err != nil {
-				state = longhorn.BackupStateUnknown
-				event(err, state, backup, volume)
-				return
+		// The engine mover reports progress through each replica's engine
+		// BackupStatus rather than through DataMover.GetProgress, since
+		// that is the only place the engine exposes per-replica outcome.
+		if _, ok := mover.(*datamover.EngineMover); ok {
+			state, warnings, errs, replicaMessages = bc.pollEngineBackupProgress(ctx, log, volume, backup, event)
+		} else {
+			state = bc.pollDataMoverProgress(ctx, log, mover, jobID, volume, backup, event)
+		}
+
+		if state == longhorn.BackupStateCompleted || state == longhorn.BackupStatePartiallyFailed {
+			bc.requestBackupVolumeResync(log, volumeName)
+		}
+	}()
+
+	return nil
+}
+
+// resumeBackupPolling re-attaches to a backup transfer started by an
+// earlier controller process, using the job dataMoverManager recorded to
+// its on-disk cache. Without this, a controller restart mid-backup would
+// leave the Backup CR InProgress forever: no goroutine would be polling
+// it, and Spec.Canceled (which cancelInflight depends on) would have
+// nothing to act on either.
+func (bc *BackupController) resumeBackupPolling(log logrus.FieldLogger, backupVolumeName string, backup *longhorn.Backup) error {
+	moverType, jobID, ok := bc.dataMoverManager.Lookup(backup.UID)
+	if !ok {
+		// Nothing recorded to resume from, most likely because this Backup
+		// CR was already InProgress before the data mover job cache existed.
+		// Nothing further can be recovered here; it needs a human to retry.
+		log.Warn("No data mover job recorded for in-progress backup, cannot resume polling")
+		return nil
+	}
+
+	volume, err := bc.ds.GetVolume(backupVolumeName)
+	if err != nil {
+		return err
+	}
+
+	event := func(err error, state longhorn.BackupState, backup *longhorn.Backup, volume *longhorn.Volume) {
+		if err != nil {
+			bc.eventRecorder.Eventf(volume, corev1.EventTypeWarning, string(state),
+				"Snapshot %s backup %s label %v: %v", backup.Spec.SnapshotName, backup.Name, backup.Spec.Labels, err)
+			return
+		}
+		bc.eventRecorder.Eventf(volume, corev1.EventTypeNormal, string(state),
+			"Snapshot %s backup %s label %v", backup.Spec.SnapshotName, backup.Name, backup.Spec.Labels)
+	}
+
+	if moverType != "" && moverType != longhorn.DataMoverTypeEngine {
+		// Unlike the engine mover, a restic/kopia execMover tracks its job by
+		// an in-process map keyed by JobID, with no persisted PID or output
+		// stream to reattach to. A fresh mover built from NewForType here
+		// would have an empty jobs map, so the very next GetProgress call
+		// would fail with "unknown job" and the backup would be
+		// misreported as BackupStateUnknown anyway - except silently, on a
+		// job we never even tried to track. Report that outcome directly
+		// instead of pretending to resume polling a mover we can't
+		// reconnect to; the underlying restic/kopia process, if it
+		// survived, is orphaned and needs a human to reconcile it.
+		log.Warnf("Cannot resume tracking %v backup job across a controller restart, marking state unknown", moverType)
+		if err := bc.dataMoverManager.Forget(backup.UID); err != nil {
+			log.WithError(err).Warn("Failed to forget data mover job")
+		}
+		bc.finalizeBackupState(log, backup.Name, volume.Name, time.Now(), longhorn.BackupStateUnknown, 0, 0, nil)
+		return nil
+	}
+
+	engine, err := bc.ds.GetVolumeCurrentEngine(backupVolumeName)
+	if err != nil {
+		return err
+	}
+	engineCollection := &engineapi.EngineCollection{}
+	engineClient, err := GetClientForEngine(engine, engineCollection, engine.Status.CurrentImage)
+	if err != nil {
+		return err
+	}
+	mover := datamover.NewEngineMover(engineClient)
+	// Seed backupNames so Cancel can still resolve this job to the backup
+	// name the engine knows it by: this mover never had StartBackup called
+	// on it, only the one before the restart did.
+	mover.Attach(jobID, backup.Name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bc.setInflight(backup.UID, &inflightBackup{cancel: cancel, mover: mover, jobID: jobID})
+
+	startTime := time.Now()
+	log.Info("Resuming progress polling for in-progress backup after controller restart")
+
+	go func() {
+		var state longhorn.BackupState
+		var warnings, errs int
+		var replicaMessages []string
+		defer func() {
+			cancel()
+			bc.clearInflight(backup.UID)
+			if err := bc.dataMoverManager.Forget(backup.UID); err != nil {
+				log.WithError(err).Warn("Failed to forget data mover job")
 			}
+			bc.finalizeBackupState(log, backup.Name, volume.Name, startTime, state, warnings, errs, replicaMessages)
+		}()
+
+		state, warnings, errs, replicaMessages = bc.pollEngineBackupProgress(ctx, log, volume, backup, event)
 
-			bks := &longhorn.BackupStatus{}
-			for _, e := range engines {
-				backupStatusList := e.Status.BackupStatus
-				for _, b := range backupStatusList {
-					if b.SnapshotName == backup.Spec.SnapshotName {
-						bks = b
-						break
-					}
+		if state == longhorn.BackupStateCompleted || state == longhorn.BackupStatePartiallyFailed {
+			bc.requestBackupVolumeResync(log, volume.Name)
+		}
+	}()
+
+	return nil
+}
+
+// pollEngineBackupProgress blocks until every engine replica involved in
+// backup.Spec.SnapshotName has either completed or failed, or ctx is
+// canceled (Spec.Canceled was observed by reconcile), then returns the
+// resulting terminal state and per-replica accounting. It returns
+// BackupStateUnknown at once if listing the volume's engines fails or no
+// replica reports a status for the snapshot.
+func (bc *BackupController) pollEngineBackupProgress(ctx context.Context, log logrus.FieldLogger, volume *longhorn.Volume, backup *longhorn.Backup,
+	event func(err error, state longhorn.BackupState, backup *longhorn.Backup, volume *longhorn.Volume)) (state longhorn.BackupState, warnings, errs int, replicaMessages []string) {
+	var lastProgressReport time.Time
+	for {
+		if ctx.Err() != nil {
+			state = longhorn.BackupStateCanceled
+			event(nil, state, backup, volume)
+			return
+		}
+
+		engines, err := bc.ds.ListVolumeEngines(volume.Name)
+		if err != nil {
+			state = longhorn.BackupStateUnknown
+			event(err, state, backup, volume)
+			return
+		}
+
+		var statuses []replicaBackupStatus
+		for _, e := range engines {
+			for _, b := range e.Status.BackupStatus {
+				if b.SnapshotName != backup.Spec.SnapshotName {
+					continue
 				}
+				statuses = append(statuses, replicaBackupStatus{ReplicaName: e.Name, Progress: b.Progress, Error: b.Error})
 			}
-			if bks == nil {
-				state = longhorn.BackupStateUnknown
-				event(err, state, backup, volume)
-				return
-			}
-			if bks.Error != "" {
-				state = longhorn.BackupStateError
-				event(errors.New(bks.Error), state, backup, volume)
+		}
+		total, completed, failed, progressSum, replicaProgress, messages := aggregateReplicaBackupStatus(statuses)
+		if total == 0 {
+			state = longhorn.BackupStateUnknown
+			event(nil, state, backup, volume)
+			return
+		}
+
+		if time.Since(lastProgressReport) >= BackupProgressReportInterval {
+			bc.reportProgress(log, backup.Name, volume.Name, progressSum/total, replicaProgress)
+			lastProgressReport = time.Now()
+		}
+
+		// Not every replica has finished or failed yet, keep polling.
+		if completed+failed < total {
+			select {
+			case <-ctx.Done():
+				state = longhorn.BackupStateCanceled
+				event(nil, state, backup, volume)
 				return
+			case <-time.After(BackupStatusQueryInterval):
 			}
+			continue
+		}
 
-			if bks.Progress != 100 {
-				time.Sleep(BackupStatusQueryInterval)
-				continue
-			}
+		errs = failed
+		replicaMessages = messages
+
+		if completed == 0 {
+			state = longhorn.BackupStateError
+			event(errors.New(strings.Join(messages, "; ")), state, backup, volume)
+			return
+		}
 
-			// TODO:
-			//   use resource monitoring https://github.com/longhorn/longhorn/issues/2441
-			//   to trigger updates backup volume to run reconcile immediately
+		// TODO:
+		//   use resource monitoring https://github.com/longhorn/longhorn/issues/2441
+		//   to trigger updates backup volume to run reconcile immediately
+		if failed > 0 {
+			warnings = failed
+			state = longhorn.BackupStatePartiallyFailed
+			event(errors.New(strings.Join(messages, "; ")), state, backup, volume)
+		} else {
 			state = longhorn.BackupStateCompleted
 			event(nil, state, backup, volume)
+		}
+		return
+	}
+}
+
+// pollDataMoverProgress blocks until mover reports jobID has reached a
+// terminal phase, or ctx is canceled (Spec.Canceled was observed by
+// reconcile), and returns the resulting BackupState. It is used for every
+// DataMover other than the engine mover, which report their own progress
+// instead of relying on per-replica engine BackupStatus.
+func (bc *BackupController) pollDataMoverProgress(ctx context.Context, log logrus.FieldLogger, mover datamover.DataMover, jobID datamover.JobID, volume *longhorn.Volume, backup *longhorn.Backup,
+	event func(err error, state longhorn.BackupState, backup *longhorn.Backup, volume *longhorn.Volume)) longhorn.BackupState {
+	var lastProgressReport time.Time
+	for {
+		if ctx.Err() != nil {
+			event(nil, longhorn.BackupStateCanceled, backup, volume)
+			return longhorn.BackupStateCanceled
+		}
 
-			syncTime := metav1.Time{Time: time.Now().UTC()}
-			backupVolume, err := bc.ds.GetBackupVolume(volumeName)
+		bytesDone, bytesTotal, phase, err := mover.GetProgress(jobID)
+
+		if bytesTotal > 0 && time.Since(lastProgressReport) >= BackupProgressReportInterval {
+			progress := int(bytesDone * 100 / bytesTotal)
+			bc.reportProgress(log, backup.Name, volume.Name, progress, map[string]int{string(jobID): progress})
+			lastProgressReport = time.Now()
+		}
+
+		// Check phase before err: a mover reports a real backup failure as
+		// phase == "Error" alongside the error that caused it, so that case
+		// must be handled as BackupStateError rather than falling through to
+		// the generic BackupStateUnknown below.
+		switch phase {
+		case "Completed":
+			if bytesTotal > 0 {
+				metrics.BackupSizeBytes.WithLabelValues(volume.Name).Set(float64(bytesTotal))
+			}
+			event(nil, longhorn.BackupStateCompleted, backup, volume)
+			return longhorn.BackupStateCompleted
+		case "Error":
 			if err == nil {
-				// Request backup_volume_controller to reconcile BackupVolume immediately.
-				backupVolume.Spec.SyncRequestedAt = syncTime
-				if _, err = bc.ds.UpdateBackupVolume(backupVolume); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
-					log.WithError(err).Errorf("Error updating backup volume %s spec", volume.Name)
-				}
-			} else if err != nil && apierrors.IsNotFound(err) {
-				// Request backup_target_controller to reconcile BackupTarget immediately.
-				backupTarget, err := bc.ds.GetBackupTarget(types.DefaultBackupTargetName)
-				if err != nil {
-					log.WithError(err).Warn("Failed to get backup target")
-					return
-				}
-				backupTarget.Spec.SyncRequestedAt = syncTime
-				if _, err = bc.ds.UpdateBackupTarget(backupTarget); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
-					log.WithError(err).Warn("Failed to update backup target")
-				}
+				err = fmt.Errorf("data mover job %v failed", jobID)
+			}
+			event(err, longhorn.BackupStateError, backup, volume)
+			return longhorn.BackupStateError
+		default:
+			if err != nil {
+				event(err, longhorn.BackupStateUnknown, backup, volume)
+				return longhorn.BackupStateUnknown
+			}
+			select {
+			case <-ctx.Done():
+				event(nil, longhorn.BackupStateCanceled, backup, volume)
+				return longhorn.BackupStateCanceled
+			case <-time.After(BackupStatusQueryInterval):
 			}
-			return
 		}
-	}()
+	}
+}
 
-	return nil
+// reportProgress updates the backup_progress gauge and writes
+// Status.Progress/Status.ReplicaProgress back to the Backup CR, skipping the
+// write when Status.Progress is unchanged. Its callers rate-limit how often
+// it runs to BackupProgressReportInterval, so a fast-polling DataMover
+// doesn't churn the API server with every poll.
+func (bc *BackupController) reportProgress(log logrus.FieldLogger, backupName, volumeName string, progress int, replicaProgress map[string]int) {
+	metrics.BackupProgress.WithLabelValues(volumeName).Set(float64(progress))
+
+	backup, err := bc.ds.GetBackup(backupName)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get backup to report progress")
+		return
+	}
+	if backup.Status.Progress == progress {
+		return
+	}
+	backup.Status.Progress = progress
+	backup.Status.ReplicaProgress = replicaProgress
+	if _, err := bc.ds.UpdateBackupStatus(backup); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
+		log.WithError(err).Warn("Failed to update backup progress")
+	}
+}
+
+// requestBackupVolumeResync asks backup_volume_controller (or, if the
+// BackupVolume doesn't exist yet, backup_target_controller) to reconcile
+// immediately instead of waiting for its regular resync interval.
+func (bc *BackupController) requestBackupVolumeResync(log logrus.FieldLogger, volumeName string) {
+	syncTime := metav1.Time{Time: time.Now().UTC()}
+	backupVolume, err := bc.ds.GetBackupVolume(volumeName)
+	if err == nil {
+		backupVolume.Spec.SyncRequestedAt = syncTime
+		if _, err = bc.ds.UpdateBackupVolume(backupVolume); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
+			log.WithError(err).Errorf("Error updating backup volume %s spec", volumeName)
+		}
+		return
+	}
+	if !apierrors.IsNotFound(err) {
+		log.WithError(err).Warn("Failed to get backup volume")
+		return
+	}
+
+	backupTarget, err := bc.ds.GetBackupTarget(types.DefaultBackupTargetName)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get backup target")
+		return
+	}
+	backupTarget.Spec.SyncRequestedAt = syncTime
+	if _, err = bc.ds.UpdateBackupTarget(backupTarget); err != nil && !apierrors.IsConflict(errors.Cause(err)) {
+		log.WithError(err).Warn("Failed to update backup target")
+	}
 }
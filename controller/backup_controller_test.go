@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregateReplicaBackupStatus(t *testing.T) {
+	cases := []struct {
+		name                string
+		statuses            []replicaBackupStatus
+		wantTotal           int
+		wantCompleted       int
+		wantFailed          int
+		wantProgressSum     int
+		wantReplicaProgress map[string]int
+		wantMessageCount    int
+	}{
+		{
+			name:                "no replicas reporting yet",
+			statuses:            nil,
+			wantReplicaProgress: map[string]int{},
+		},
+		{
+			name: "all replicas completed",
+			statuses: []replicaBackupStatus{
+				{ReplicaName: "r1", Progress: 100},
+				{ReplicaName: "r2", Progress: 100},
+			},
+			wantTotal:           2,
+			wantCompleted:       2,
+			wantProgressSum:     200,
+			wantReplicaProgress: map[string]int{"r1": 100, "r2": 100},
+		},
+		{
+			name: "one replica fails, one completes",
+			statuses: []replicaBackupStatus{
+				{ReplicaName: "r1", Progress: 100},
+				{ReplicaName: "r2", Progress: 40, Error: "disk full"},
+			},
+			wantTotal:           2,
+			wantCompleted:       1,
+			wantFailed:          1,
+			wantProgressSum:     140,
+			wantReplicaProgress: map[string]int{"r1": 100, "r2": 40},
+			wantMessageCount:    1,
+		},
+		{
+			name: "still in progress",
+			statuses: []replicaBackupStatus{
+				{ReplicaName: "r1", Progress: 50},
+			},
+			wantTotal:           1,
+			wantProgressSum:     50,
+			wantReplicaProgress: map[string]int{"r1": 50},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			total, completed, failed, progressSum, replicaProgress, messages := aggregateReplicaBackupStatus(c.statuses)
+			if total != c.wantTotal || completed != c.wantCompleted || failed != c.wantFailed || progressSum != c.wantProgressSum {
+				t.Fatalf("got (total=%d completed=%d failed=%d progressSum=%d), want (total=%d completed=%d failed=%d progressSum=%d)",
+					total, completed, failed, progressSum, c.wantTotal, c.wantCompleted, c.wantFailed, c.wantProgressSum)
+			}
+			if !reflect.DeepEqual(replicaProgress, c.wantReplicaProgress) {
+				t.Fatalf("replicaProgress = %v, want %v", replicaProgress, c.wantReplicaProgress)
+			}
+			if len(messages) != c.wantMessageCount {
+				t.Fatalf("len(messages) = %d, want %d", len(messages), c.wantMessageCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Package datamover abstracts the mechanism used to ship a volume's
+// point-in-time data to a backup target. The engine's native snapshot
+// backup is the default, but filesystem-level movers such as restic and
+// kopia can back up volumes that have no Longhorn engine snapshot (e.g.
+// backing images or file-system PVCs mounted outside Longhorn).
+package datamover
+
+import (
+	"context"
+	"fmt"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta1"
+)
+
+// JobID identifies a single in-flight or completed data-mover invocation.
+// Its format is mover-specific: the engine mover uses the snapshot name,
+// while the restic/kopia movers use "<volume name>-<backup name>".
+type JobID string
+
+// Snapshot describes the point-in-time data a DataMover is asked to ship to
+// a backup target.
+type Snapshot struct {
+	VolumeName           string
+	SnapshotName         string
+	BackupName           string
+	Labels               map[string]string
+	BackingImageName     string
+	BackingImageChecksum string
+}
+
+// DataMover is implemented by every backend capable of moving a snapshot's
+// data to a backup target. BackupController.backupCreation drives whichever
+// implementation Backup.Spec.DataMover selects without needing to know how
+// the transfer actually happens.
+type DataMover interface {
+	// StartBackup begins transferring snapshot to target using credential
+	// and returns a JobID that GetProgress/Cancel can be called with.
+	StartBackup(ctx context.Context, snapshot *Snapshot, target string, credential map[string]string) (JobID, error)
+	// GetProgress reports how much of the transfer has completed. phase is
+	// a short mover-specific description (e.g. "InProgress", "Completed").
+	GetProgress(id JobID) (bytesDone, bytesTotal int64, phase string, err error)
+	// Cancel aborts an in-flight job. It must be safe to call on a job that
+	// has already finished.
+	Cancel(id JobID) error
+}
+
+// snapshotMountPath returns where the filesystem movers expect to find the
+// volume's data mounted for a given snapshot. It is a thin indirection so
+// the real mount layout can be plugged in without touching ResticMover or
+// KopiaMover.
+func snapshotMountPath(snapshot *Snapshot) string {
+	return fmt.Sprintf("/var/lib/longhorn-backupstore-mounts/%v/%v", snapshot.VolumeName, snapshot.SnapshotName)
+}
+
+// NewForType returns the DataMover implementation selected by moverType,
+// falling back to the engine mover for backward compatibility when
+// moverType is empty.
+func NewForType(moverType longhorn.DataMoverType, engine *EngineMover) (DataMover, error) {
+	switch moverType {
+	case "", longhorn.DataMoverTypeEngine:
+		return engine, nil
+	case longhorn.DataMoverTypeRestic:
+		return NewResticMover(), nil
+	case longhorn.DataMoverTypeKopia:
+		return NewKopiaMover(), nil
+	default:
+		return nil, fmt.Errorf("unsupported data mover %v", moverType)
+	}
+}
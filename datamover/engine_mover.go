@@ -0,0 +1,79 @@
+package datamover
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/longhorn/longhorn-manager/engineapi"
+)
+
+// EngineMover is the default DataMover. It delegates the actual transfer to
+// the Longhorn engine's own snapshot-backup mechanism, so StartBackup only
+// has to kick off the engine-side job; BackupController.backupCreation
+// already knows how to poll per-replica BackupStatus for this mover's
+// progress, so GetProgress is not used on this path.
+type EngineMover struct {
+	engineClient engineapi.EngineClient
+
+	mu          sync.Mutex
+	backupNames map[JobID]string
+}
+
+// NewEngineMover wraps engineClient, the engine of the volume being backed
+// up, as a DataMover.
+func NewEngineMover(engineClient engineapi.EngineClient) *EngineMover {
+	return &EngineMover{
+		engineClient: engineClient,
+		backupNames:  map[JobID]string{},
+	}
+}
+
+func (m *EngineMover) StartBackup(ctx context.Context, snapshot *Snapshot, target string, credential map[string]string) (JobID, error) {
+	if _, err := m.engineClient.SnapshotBackup(snapshot.BackupName, snapshot.SnapshotName, target,
+		snapshot.BackingImageName, snapshot.BackingImageChecksum, snapshot.Labels, credential); err != nil {
+		return "", err
+	}
+	// The engine has no separate job identifier; the snapshot name is
+	// already how replicas key their BackupStatus entries.
+	id := JobID(snapshot.SnapshotName)
+
+	m.mu.Lock()
+	m.backupNames[id] = snapshot.BackupName
+	m.mu.Unlock()
+
+	return id, nil
+}
+
+// Attach records that id refers to backupName without starting a new engine
+// backup. It exists so a controller restart can reconstruct an EngineMover
+// that knows how to Cancel a backup it did not itself start with
+// StartBackup, since resuming polling after a restart builds a fresh
+// EngineMover around the same still-running engine job.
+func (m *EngineMover) Attach(id JobID, backupName string) {
+	m.mu.Lock()
+	m.backupNames[id] = backupName
+	m.mu.Unlock()
+}
+
+// GetProgress is not implemented for the engine mover: BackupController
+// polls the per-replica engine BackupStatus directly, since that is the
+// only place engine backup progress is reported.
+func (m *EngineMover) GetProgress(id JobID) (bytesDone, bytesTotal int64, phase string, err error) {
+	return 0, 0, "", fmt.Errorf("GetProgress is not supported by the engine mover, poll replica BackupStatus instead")
+}
+
+// Cancel asks the engine to abort the snapshot backup started for id. It is
+// safe to call on a job that has already finished; the engine treats
+// cancellation of a completed/absent snapshot backup as a no-op.
+func (m *EngineMover) Cancel(id JobID) error {
+	m.mu.Lock()
+	backupName, ok := m.backupNames[id]
+	delete(m.backupNames, id)
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return m.engineClient.SnapshotBackupCancel(backupName, string(id))
+}
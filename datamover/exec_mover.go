@@ -0,0 +1,133 @@
+package datamover
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// execJob tracks one in-flight CLI-backed backup invocation.
+type execJob struct {
+	cancel     context.CancelFunc
+	bytesDone  int64
+	bytesTotal int64
+	phase      string
+	err        error
+}
+
+// execMover is the shared scaffolding for filesystem-level movers that shell
+// out to a CLI tool (restic, kopia) to do the actual transfer. Callers embed
+// it and supply binary-specific StartBackup/progress parsing.
+type execMover struct {
+	binary string
+
+	mu   sync.Mutex
+	jobs map[JobID]*execJob
+}
+
+func newExecMover(binary string) *execMover {
+	return &execMover{
+		binary: binary,
+		jobs:   map[JobID]*execJob{},
+	}
+}
+
+// run starts cmd in the background under a cancellable context, tracks it
+// under id, and calls parseProgress with every line the command writes to
+// stdout so subclasses can translate tool-specific progress output into
+// bytesDone/bytesTotal/phase. env is appended to the process's environment,
+// on top of the mover binary's own environment, so repository credentials
+// can be passed through without appearing on the command line.
+func (m *execMover) run(id JobID, args []string, env []string, parseProgress func(line string, job *execJob)) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &execJob{cancel: cancel, phase: "InProgress"}
+
+	m.mu.Lock()
+	m.jobs[id] = job
+	m.mu.Unlock()
+
+	cmd := exec.CommandContext(ctx, m.binary, args...)
+	cmd.Env = append(os.Environ(), env...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return errors.Wrapf(err, "failed to open stdout pipe for %v", m.binary)
+	}
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return errors.Wrapf(err, "failed to start %v", m.binary)
+	}
+
+	go func() {
+		scanLines(stdout, func(line string) {
+			m.mu.Lock()
+			parseProgress(line, job)
+			m.mu.Unlock()
+		})
+
+		err := cmd.Wait()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if err != nil {
+			job.err = err
+			job.phase = "Error"
+			return
+		}
+		job.phase = "Completed"
+		job.bytesDone = job.bytesTotal
+	}()
+
+	return nil
+}
+
+// runOnce runs a short-lived, blocking invocation of the mover binary (e.g.
+// a repository connect/init step that must finish before the long-running
+// transfer is started) and returns its combined output for error reporting.
+func (m *execMover) runOnce(ctx context.Context, args []string, env []string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, m.binary, args...)
+	cmd.Env = append(os.Environ(), env...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return out, errors.Wrapf(err, "%v %v failed: %s", m.binary, args, out)
+	}
+	return out, nil
+}
+
+// credentialEnv converts a backup target credential map, whose keys are
+// already environment variable names (e.g. RESTIC_PASSWORD,
+// AWS_ACCESS_KEY_ID), into a slice suitable for exec.Cmd.Env.
+func credentialEnv(credential map[string]string) []string {
+	env := make([]string, 0, len(credential))
+	for k, v := range credential {
+		env = append(env, fmt.Sprintf("%v=%v", k, v))
+	}
+	return env
+}
+
+func (m *execMover) getProgress(id JobID) (bytesDone, bytesTotal int64, phase string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return 0, 0, "", fmt.Errorf("unknown job %v", id)
+	}
+	return job.bytesDone, job.bytesTotal, job.phase, job.err
+}
+
+func (m *execMover) cancel(id JobID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		// Safe to call on a job that has already finished or never existed.
+		return nil
+	}
+	job.cancel()
+	return nil
+}
@@ -0,0 +1,110 @@
+package datamover
+
+import "testing"
+
+func TestParseKopiaProgress(t *testing.T) {
+	cases := []struct {
+		name           string
+		line           string
+		wantBytesDone  int64
+		wantBytesTotal int64
+	}{
+		{
+			name:           "uploaded and cached bytes are summed",
+			line:           `{"uploadedBytes":300,"cachedBytes":200,"estimatedSize":1024}`,
+			wantBytesDone:  500,
+			wantBytesTotal: 1024,
+		},
+		{
+			name:           "zero estimated size leaves total untouched",
+			line:           `{"uploadedBytes":50,"cachedBytes":0,"estimatedSize":0}`,
+			wantBytesDone:  50,
+			wantBytesTotal: 1024,
+		},
+		{
+			name:           "malformed json is ignored",
+			line:           `not json`,
+			wantBytesDone:  100,
+			wantBytesTotal: 1024,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			job := &execJob{bytesDone: 100, bytesTotal: 1024}
+			parseKopiaProgress(c.line, job)
+			if job.bytesDone != c.wantBytesDone || job.bytesTotal != c.wantBytesTotal {
+				t.Fatalf("got (bytesDone=%d bytesTotal=%d), want (bytesDone=%d bytesTotal=%d)",
+					job.bytesDone, job.bytesTotal, c.wantBytesDone, c.wantBytesTotal)
+			}
+		})
+	}
+}
+
+func TestKopiaConnectArgs(t *testing.T) {
+	cases := []struct {
+		name       string
+		target     string
+		credential map[string]string
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:   "s3 target with prefix and endpoint",
+			target: "s3://my-bucket@us-east-1/backups/vol1",
+			credential: map[string]string{
+				"AWS_ENDPOINTS": "https://s3.example.com",
+			},
+			want: []string{"repository", "connect", "s3", "--bucket=my-bucket",
+				"--prefix=backups/vol1", "--endpoint=https://s3.example.com"},
+		},
+		{
+			name:   "s3 target without prefix or endpoint",
+			target: "s3://my-bucket@us-east-1",
+			want:   []string{"repository", "connect", "s3", "--bucket=my-bucket"},
+		},
+		{
+			name:   "nfs target",
+			target: "nfs://server:/exports/backups",
+			want:   []string{"repository", "connect", "filesystem", "--path=/exports/backups"},
+		},
+		{
+			name:   "empty scheme treated as filesystem",
+			target: "/mnt/backups",
+			want:   []string{"repository", "connect", "filesystem", "--path=/mnt/backups"},
+		},
+		{
+			name:    "unsupported scheme",
+			target:  "azblob://container/path",
+			wantErr: true,
+		},
+		{
+			name:    "unparseable target",
+			target:  "://bad",
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := kopiaConnectArgs(c.target, c.credential)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got args %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(c.want) {
+				t.Fatalf("got %v, want %v", got, c.want)
+			}
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Fatalf("got %v, want %v", got, c.want)
+				}
+			}
+		})
+	}
+}
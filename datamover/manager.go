@@ -0,0 +1,99 @@
+package datamover
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta1"
+)
+
+// job is the state Manager keeps for one in-flight DataMover invocation.
+type job struct {
+	MoverType longhorn.DataMoverType `json:"moverType"`
+	JobID     JobID                  `json:"jobID"`
+}
+
+// Manager tracks every in-flight DataMover job, keyed by the UID of the
+// Backup CR that owns it. It persists that mapping to a per-node cache file
+// after every change so a controller that restarts mid-transfer can
+// rediscover its jobs and resume polling them instead of losing track of an
+// upload that is still running on the node.
+type Manager struct {
+	mu        sync.Mutex
+	cachePath string
+	jobs      map[types.UID]job
+}
+
+// NewManager returns a Manager whose cache is persisted to cachePath,
+// reconciling any jobs already recorded there (e.g. from before a controller
+// restart).
+func NewManager(cachePath string) (*Manager, error) {
+	m := &Manager{
+		cachePath: cachePath,
+		jobs:      map[types.UID]job{},
+	}
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Track records that backupUID's transfer is running as jobID on mover, and
+// persists the update so a controller restart can find it again.
+func (m *Manager) Track(backupUID types.UID, moverType longhorn.DataMoverType, jobID JobID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.jobs[backupUID] = job{MoverType: moverType, JobID: jobID}
+	return m.save()
+}
+
+// Lookup returns the job tracked for backupUID, if any.
+func (m *Manager) Lookup(backupUID types.UID) (moverType longhorn.DataMoverType, jobID JobID, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	j, ok := m.jobs[backupUID]
+	return j.MoverType, j.JobID, ok
+}
+
+// Forget drops backupUID's job once its Backup CR no longer needs polling
+// (completed, failed, or deleted), and persists the update.
+func (m *Manager) Forget(backupUID types.UID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.jobs, backupUID)
+	return m.save()
+}
+
+func (m *Manager) load() error {
+	data, err := ioutil.ReadFile(m.cachePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to read data mover job cache %v", m.cachePath)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &m.jobs)
+}
+
+// save must be called with m.mu held.
+func (m *Manager) save() error {
+	data, err := json.Marshal(m.jobs)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal data mover job cache")
+	}
+	if err := ioutil.WriteFile(m.cachePath, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write data mover job cache %v", m.cachePath)
+	}
+	return nil
+}
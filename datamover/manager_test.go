@@ -0,0 +1,61 @@
+package datamover
+
+import (
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta1"
+)
+
+func TestManagerTrackLookupForget(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "datamover-jobs.json")
+
+	m, err := NewManager(cachePath)
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+
+	uid := types.UID("backup-1")
+	if _, _, ok := m.Lookup(uid); ok {
+		t.Fatal("Lookup found a job before any was tracked")
+	}
+
+	if err := m.Track(uid, longhorn.DataMoverTypeRestic, JobID("job-1")); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	moverType, jobID, ok := m.Lookup(uid)
+	if !ok || moverType != longhorn.DataMoverTypeRestic || jobID != JobID("job-1") {
+		t.Fatalf("got (moverType=%v jobID=%v ok=%v), want (restic, job-1, true)", moverType, jobID, ok)
+	}
+
+	if err := m.Forget(uid); err != nil {
+		t.Fatalf("Forget failed: %v", err)
+	}
+	if _, _, ok := m.Lookup(uid); ok {
+		t.Fatal("Lookup found a job after it was forgotten")
+	}
+}
+
+func TestManagerSurvivesRestart(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "datamover-jobs.json")
+
+	first, err := NewManager(cachePath)
+	if err != nil {
+		t.Fatalf("NewManager failed: %v", err)
+	}
+	uid := types.UID("backup-1")
+	if err := first.Track(uid, longhorn.DataMoverTypeKopia, JobID("job-1")); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	second, err := NewManager(cachePath)
+	if err != nil {
+		t.Fatalf("NewManager failed to load persisted cache: %v", err)
+	}
+	moverType, jobID, ok := second.Lookup(uid)
+	if !ok || moverType != longhorn.DataMoverTypeKopia || jobID != JobID("job-1") {
+		t.Fatalf("got (moverType=%v jobID=%v ok=%v), want (kopia, job-1, true)", moverType, jobID, ok)
+	}
+}
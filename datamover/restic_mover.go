@@ -0,0 +1,66 @@
+package datamover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// resticSummary is the subset of a restic `--json` backup output line (a
+// status or summary message) this mover cares about.
+type resticSummary struct {
+	MessageType string `json:"message_type"`
+	BytesDone   int64  `json:"bytes_done"`
+	TotalBytes  int64  `json:"total_bytes"`
+}
+
+// ResticMover backs up a snapshot's data using the restic CLI. Unlike
+// EngineMover it needs no Longhorn engine snapshot: it walks the mounted
+// snapshot directory directly, which is what lets it back up file-system
+// PVCs and backing images that have no engine behind them.
+type ResticMover struct {
+	*execMover
+}
+
+// NewResticMover returns a ResticMover that invokes the restic binary found
+// on PATH.
+func NewResticMover() *ResticMover {
+	return &ResticMover{execMover: newExecMover("restic")}
+}
+
+func (m *ResticMover) StartBackup(ctx context.Context, snapshot *Snapshot, target string, credential map[string]string) (JobID, error) {
+	id := JobID(fmt.Sprintf("%v-%v", snapshot.VolumeName, snapshot.BackupName))
+	args := []string{"backup", "--json", "-r", target, snapshotMountPath(snapshot)}
+	// Repository password and object-store keys (e.g. RESTIC_PASSWORD,
+	// AWS_ACCESS_KEY_ID) are passed as environment variables rather than
+	// flags, matching how restic itself expects to receive them.
+	if err := m.run(id, args, credentialEnv(credential), parseResticProgress); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (m *ResticMover) GetProgress(id JobID) (bytesDone, bytesTotal int64, phase string, err error) {
+	return m.getProgress(id)
+}
+
+func (m *ResticMover) Cancel(id JobID) error {
+	return m.cancel(id)
+}
+
+func parseResticProgress(line string, job *execJob) {
+	if !strings.HasPrefix(line, "{") {
+		return
+	}
+	var summary resticSummary
+	if err := json.Unmarshal([]byte(line), &summary); err != nil {
+		return
+	}
+	if summary.BytesDone > 0 {
+		job.bytesDone = summary.BytesDone
+	}
+	if summary.TotalBytes > 0 {
+		job.bytesTotal = summary.TotalBytes
+	}
+}
@@ -0,0 +1,48 @@
+package datamover
+
+import "testing"
+
+func TestParseResticProgress(t *testing.T) {
+	cases := []struct {
+		name           string
+		line           string
+		wantBytesDone  int64
+		wantBytesTotal int64
+	}{
+		{
+			name:           "status line updates both totals",
+			line:           `{"message_type":"status","bytes_done":512,"total_bytes":1024}`,
+			wantBytesDone:  512,
+			wantBytesTotal: 1024,
+		},
+		{
+			name:           "zero fields are not applied",
+			line:           `{"message_type":"status","bytes_done":0,"total_bytes":0}`,
+			wantBytesDone:  100,
+			wantBytesTotal: 1024,
+		},
+		{
+			name:           "non-json line is ignored",
+			line:           "reading cache...",
+			wantBytesDone:  100,
+			wantBytesTotal: 1024,
+		},
+		{
+			name:           "malformed json is ignored",
+			line:           `{"message_type":`,
+			wantBytesDone:  100,
+			wantBytesTotal: 1024,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			job := &execJob{bytesDone: 100, bytesTotal: 1024}
+			parseResticProgress(c.line, job)
+			if job.bytesDone != c.wantBytesDone || job.bytesTotal != c.wantBytesTotal {
+				t.Fatalf("got (bytesDone=%d bytesTotal=%d), want (bytesDone=%d bytesTotal=%d)",
+					job.bytesDone, job.bytesTotal, c.wantBytesDone, c.wantBytesTotal)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package datamover
+
+import (
+	"bufio"
+	"io"
+)
+
+// scanLines reads r line by line, calling onLine for each, until EOF or a
+// read error. It is used to turn a CLI tool's streamed stdout into
+// incremental progress updates.
+func scanLines(r io.Reader, onLine func(line string)) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+}
@@ -5,33 +5,97 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 type BackupState string
 
 const (
-	BackupStateInProgress = BackupState("InProgress")
-	BackupStateCompleted  = BackupState("Completed")
-	BackupStateError      = BackupState("Error")
-	BackupStateUnknown    = BackupState("Unknown")
+	BackupStateInProgress      = BackupState("InProgress")
+	BackupStateCompleted       = BackupState("Completed")
+	BackupStatePartiallyFailed = BackupState("PartiallyFailed")
+	BackupStateError           = BackupState("Error")
+	BackupStateUnknown         = BackupState("Unknown")
+	// BackupStateCanceled is set once BackupController has observed
+	// Spec.Canceled on an InProgress backup and torn down its DataMover job.
+	BackupStateCanceled = BackupState("Canceled")
+)
+
+// MaxBackupStatusMessages bounds the number of per-replica failure messages
+// retained in SnapshotBackupStatus.ReplicaMessages so a backup with many
+// failing replicas doesn't grow the CR without bound.
+const MaxBackupStatusMessages = 10
+
+// DataMoverType selects the backend BackupController uses to ship a
+// snapshot's data to the backup target.
+type DataMoverType string
+
+const (
+	// DataMoverTypeEngine backs up a volume's data through the Longhorn
+	// engine's own snapshot-backup mechanism. It is the default and the
+	// only mover available to volumes without a filesystem mounted
+	// outside Longhorn.
+	DataMoverTypeEngine = DataMoverType("engine")
+	// DataMoverTypeRestic backs up a volume's data using restic, which can
+	// operate on a plain filesystem PVC or backing image with no engine
+	// snapshot involved.
+	DataMoverTypeRestic = DataMoverType("restic")
+	// DataMoverTypeKopia is the kopia equivalent of DataMoverTypeRestic.
+	DataMoverTypeKopia = DataMoverType("kopia")
 )
 
 type SnapshotBackupSpec struct {
 	SyncRequestedAt metav1.Time       `json:"syncRequestedAt"`
 	SnapshotName    string            `json:"snapshotName"`
 	Labels          map[string]string `json:"labels"`
+	// VolumeName is the name of the backup volume this Backup belongs to.
+	// It supersedes the types.LonghornLabelBackupVolume label, which is not
+	// visible in the CRD schema and cannot be validated. Backup CRs created
+	// before this field existed fall back to the label for one release; new
+	// Backup CRs are expected to set this field instead.
+	VolumeName string `json:"volumeName"`
+	// BackupTargetName is the name of the BackupTarget this Backup syncs
+	// against. An empty value falls back to types.DefaultBackupTargetName,
+	// matching the behavior before this field existed.
+	BackupTargetName string `json:"backupTargetName"`
+	// DataMover selects the backend used to transfer this backup's data.
+	// An empty value is treated as DataMoverTypeEngine for backward
+	// compatibility with Backup CRs created before this field existed.
+	DataMover DataMoverType `json:"dataMover"`
+	// Canceled requests that an InProgress backup be aborted. It has no
+	// effect once the backup has already reached a terminal state.
+	Canceled bool `json:"canceled"`
 }
 
 type SnapshotBackupStatus struct {
-	OwnerID                string            `json:"ownerID"`
-	State                  BackupState       `json:"state"`
-	URL                    string            `json:"url"`
-	SnapshotName           string            `json:"snapshotName"`
-	SnapshotCreatedAt      string            `json:"snapshotCreatedAt"`
-	BackupCreatedAt        string            `json:"backupCreatedAt"`
-	Size                   string            `json:"size"`
-	Labels                 map[string]string `json:"labels"`
-	Messages               map[string]string `json:"messages"`
-	VolumeName             string            `json:"volumeName"`
-	VolumeSize             string            `json:"volumeSize"`
-	VolumeCreated          string            `json:"volumeCreated"`
-	VolumeBackingImageName string            `json:"volumeBackingImageName"`
-	LastSyncedAt           metav1.Time       `json:"lastSyncedAt"`
+	OwnerID           string            `json:"ownerID"`
+	State             BackupState       `json:"state"`
+	URL               string            `json:"url"`
+	SnapshotName      string            `json:"snapshotName"`
+	SnapshotCreatedAt string            `json:"snapshotCreatedAt"`
+	BackupCreatedAt   string            `json:"backupCreatedAt"`
+	Size              string            `json:"size"`
+	Labels            map[string]string `json:"labels"`
+	Messages          map[string]string `json:"messages"`
+	// Warnings is the number of engine replicas that reported a non-fatal
+	// backup error while at least one other replica completed successfully.
+	Warnings int `json:"warnings"`
+	// Errors is the number of engine replicas that reported a backup error.
+	// If it equals the number of replicas involved in the backup, the
+	// backup transitions to BackupStateError instead of
+	// BackupStatePartiallyFailed.
+	Errors int `json:"errors"`
+	// ReplicaMessages is a bounded ring buffer of the most recent
+	// per-replica/per-chunk failure messages seen while polling the
+	// engine, capped at MaxBackupStatusMessages entries.
+	ReplicaMessages []string `json:"replicaMessages"`
+	// Progress is the percentage (0-100) complete as of the most recent
+	// poll of an in-progress backup.
+	Progress int `json:"progress"`
+	// ReplicaProgress is the percentage (0-100) complete per replica as of
+	// the most recent poll. For the engine mover it has one entry per
+	// replica; for any other DataMover it has a single entry keyed by the
+	// mover's JobID.
+	ReplicaProgress        map[string]int `json:"replicaProgress"`
+	VolumeName             string         `json:"volumeName"`
+	VolumeSize             string         `json:"volumeSize"`
+	VolumeCreated          string         `json:"volumeCreated"`
+	VolumeBackingImageName string         `json:"volumeBackingImageName"`
+	LastSyncedAt           metav1.Time    `json:"lastSyncedAt"`
 }
 
 // +genclient
@@ -50,4 +114,4 @@ type BackupList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
 	Items           []Backup `json:"items"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,50 @@
+// Package metrics registers the Prometheus collectors longhorn-manager
+// exposes for operators to chart backup throughput and drive alerting.
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// BackupProgress is the percentage (0-100) complete as of the most
+	// recent poll of a volume's in-progress backup. It is labeled by volume
+	// rather than by Backup CR: Longhorn creates a new Backup CR per backup
+	// run, so a per-backup label would leave an abandoned time series
+	// behind forever for every backup that has ever run. A volume only has
+	// one backup in progress at a time, so this label is enough to chart
+	// current progress.
+	BackupProgress = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "longhorn",
+		Name:      "backup_progress",
+		Help:      "Percentage complete of the volume's most recently polled backup",
+	}, []string{"volume"})
+
+	// BackupDurationSeconds observes wall-clock time from a backup entering
+	// BackupStateInProgress to reaching a terminal state. For a backup
+	// whose polling was resumed after a controller restart, the time is
+	// measured from the resume instead.
+	BackupDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "longhorn",
+		Name:      "backup_duration_seconds",
+		Help:      "Time a backup took to reach a terminal state",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 15),
+	}, []string{"volume", "state"})
+
+	// BackupSizeBytes is the size of the most recently completed backup.
+	BackupSizeBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "longhorn",
+		Name:      "backup_size_bytes",
+		Help:      "Size in bytes of the most recently completed backup",
+	}, []string{"volume"})
+
+	// BackupState counts every backup that has reached a given terminal
+	// state, so operators can alert on a rising PartiallyFailed/Error rate.
+	BackupState = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "longhorn",
+		Name:      "backup_state",
+		Help:      "Count of backups that have reached each terminal state",
+	}, []string{"state"})
+)
+
+func init() {
+	prometheus.MustRegister(BackupProgress, BackupDurationSeconds, BackupSizeBytes, BackupState)
+}
@@ -0,0 +1,65 @@
+// Package webhook implements longhorn-manager's validating admission
+// webhook handlers.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta1"
+)
+
+// ValidateBackup rejects a Backup CR create if it does not set
+// Spec.VolumeName. It is registered as the handler for the Backup resource's
+// validating webhook path so new Backup CRs can no longer be created
+// relying solely on the deprecated types.LonghornLabelBackupVolume label,
+// which BackupController.getBackupVolumeName only accepts as a fallback for
+// CRs that already existed before this field was introduced.
+func ValidateBackup(w http.ResponseWriter, r *http.Request) {
+	review, err := readAdmissionReview(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := &admissionv1.AdmissionResponse{
+		UID:     review.Request.UID,
+		Allowed: true,
+	}
+
+	if review.Request.Operation == admissionv1.Create {
+		var backup longhorn.Backup
+		if err := json.Unmarshal(review.Request.Object.Raw, &backup); err != nil {
+			response.Allowed = false
+			response.Result = &metav1.Status{Message: fmt.Sprintf("failed to decode Backup: %v", err)}
+		} else if backup.Spec.VolumeName == "" {
+			response.Allowed = false
+			response.Result = &metav1.Status{Message: "spec.volumeName is required"}
+		}
+	}
+
+	writeAdmissionReview(w, review, response)
+}
+
+func readAdmissionReview(r *http.Request) (*admissionv1.AdmissionReview, error) {
+	review := &admissionv1.AdmissionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+		return nil, fmt.Errorf("failed to decode admission review: %v", err)
+	}
+	if review.Request == nil {
+		return nil, fmt.Errorf("admission review has no request")
+	}
+	return review, nil
+}
+
+func writeAdmissionReview(w http.ResponseWriter, review *admissionv1.AdmissionReview, response *admissionv1.AdmissionResponse) {
+	review.Response = response
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
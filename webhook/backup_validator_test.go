@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta1"
+)
+
+func postBackupCreateReview(t *testing.T, backup *longhorn.Backup) *admissionv1.AdmissionResponse {
+	t.Helper()
+
+	raw, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatalf("failed to marshal backup: %v", err)
+	}
+	review := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:       "test-uid",
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("failed to marshal admission review: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, validationPathBackup, strings.NewReader(string(body)))
+	rec := httptest.NewRecorder()
+
+	ValidateBackup(rec, req)
+
+	var got admissionv1.AdmissionReview
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if got.Response == nil {
+		t.Fatalf("response has no Response field")
+	}
+	return got.Response
+}
+
+func TestValidateBackupRejectsMissingVolumeName(t *testing.T) {
+	resp := postBackupCreateReview(t, &longhorn.Backup{})
+	if resp.Allowed {
+		t.Fatal("expected a Backup without spec.volumeName to be rejected")
+	}
+}
+
+func TestValidateBackupAcceptsVolumeName(t *testing.T) {
+	resp := postBackupCreateReview(t, &longhorn.Backup{
+		Spec: longhorn.SnapshotBackupSpec{VolumeName: "vol-1"},
+	})
+	if !resp.Allowed {
+		t.Fatalf("expected a Backup with spec.volumeName set to be accepted, got: %v", resp.Result)
+	}
+}
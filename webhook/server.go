@@ -0,0 +1,17 @@
+package webhook
+
+import "net/http"
+
+// validationPathBackup is the HTTP path ValidateBackup is served on. It
+// must match the clientConfig.service.path of the backup rule in
+// manifests/validating-webhook-configuration.yaml.
+const validationPathBackup = "/v1/webhook/validation/backup"
+
+// NewServeMux returns the HTTP route table for longhorn-manager's
+// validating admission webhook server, wiring each resource's validator to
+// the path its ValidatingWebhookConfiguration rule targets.
+func NewServeMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(validationPathBackup, ValidateBackup)
+	return mux
+}